@@ -613,6 +613,130 @@ func TestRuntimePublicAccountKeys(t *testing.T) {
 		assert.Equal(t, expectedValue, optionalValue.Value)
 		assert.Equal(t, revokedAccountKeyA, storage.returnedKey)
 	})
+
+	t.Run("count", func(t *testing.T) {
+
+		t.Parallel()
+
+		storage := newTestAccountKeyStorage()
+		storage.keys = append(storage.keys, revokedAccountKeyA, accountKeyB)
+
+		runtime := newTestInterpreterRuntime()
+		runtimeInterface := getAccountKeyTestRuntimeInterface(storage)
+
+		test := accountKeyTestCase{
+			code: `
+              pub fun main(): UInt64 {
+                  return getAccount(0x02).keys.count
+              }
+            `,
+			args: []cadence.Value{},
+		}
+
+		value, err := test.executeScript(runtime, runtimeInterface)
+		require.NoError(t, err)
+
+		assert.Equal(t, cadence.UInt64(2), value)
+	})
+
+	t.Run("count, no keys", func(t *testing.T) {
+
+		t.Parallel()
+
+		storage := newTestAccountKeyStorage()
+
+		runtime := newTestInterpreterRuntime()
+		runtimeInterface := getAccountKeyTestRuntimeInterface(storage)
+
+		test := accountKeyTestCase{
+			code: `
+              pub fun main(): UInt64 {
+                  return getAccount(0x02).keys.count
+              }
+            `,
+			args: []cadence.Value{},
+		}
+
+		value, err := test.executeScript(runtime, runtimeInterface)
+		require.NoError(t, err)
+
+		assert.Equal(t, cadence.UInt64(0), value)
+	})
+
+	t.Run("forEach", func(t *testing.T) {
+
+		t.Parallel()
+
+		storage := newTestAccountKeyStorage()
+		storage.keys = append(storage.keys, revokedAccountKeyA, accountKeyB)
+
+		runtime := newTestInterpreterRuntime()
+		runtimeInterface := getAccountKeyTestRuntimeInterface(storage)
+
+		test := accountKeyTestCase{
+			code: `
+              pub fun main(): [Int] {
+                  let keyIndices: [Int] = []
+                  getAccount(0x02).keys.forEach(fun (key: AccountKey): Bool {
+                      keyIndices.append(key.keyIndex)
+                      return true
+                  })
+                  return keyIndices
+              }
+            `,
+			args: []cadence.Value{},
+		}
+
+		value, err := test.executeScript(runtime, runtimeInterface)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			cadence.NewArray([]cadence.Value{
+				cadence.NewInt(0),
+				cadence.NewInt(1),
+			}).WithType(cadence.VariableSizedArrayType{
+				ElementType: cadence.IntType{},
+			}),
+			value,
+		)
+	})
+
+	t.Run("forEach, stop iteration", func(t *testing.T) {
+
+		t.Parallel()
+
+		storage := newTestAccountKeyStorage()
+		storage.keys = append(storage.keys, accountKeyA, accountKeyB)
+
+		runtime := newTestInterpreterRuntime()
+		runtimeInterface := getAccountKeyTestRuntimeInterface(storage)
+
+		test := accountKeyTestCase{
+			code: `
+              pub fun main(): [Int] {
+                  let keyIndices: [Int] = []
+                  getAccount(0x02).keys.forEach(fun (key: AccountKey): Bool {
+                      keyIndices.append(key.keyIndex)
+                      return false
+                  })
+                  return keyIndices
+              }
+            `,
+			args: []cadence.Value{},
+		}
+
+		value, err := test.executeScript(runtime, runtimeInterface)
+		require.NoError(t, err)
+
+		assert.Equal(t,
+			cadence.NewArray([]cadence.Value{
+				cadence.NewInt(0),
+			}).WithType(cadence.VariableSizedArrayType{
+				ElementType: cadence.IntType{},
+			}),
+			value,
+		)
+	})
 }
 
 func TestRuntimeHashAlgorithm(t *testing.T) {
@@ -18,4 +18,15 @@
 
 package format
 
+import (
+	"fmt"
+)
+
 const HostFunction = "Function(...)"
+
+func NamedHostFunction(name string) string {
+	return fmt.Sprintf(
+		"Function %s(...)",
+		name,
+	)
+}
@@ -97,7 +97,7 @@ func NewAuthAccountValue(
 		case sema.AuthAccountStorageCapacityField:
 			return storageCapacityGet(inter)
 		case sema.AuthAccountTypeField:
-			return inter.authAccountTypeFunction(address)
+			return inter.accountTypeFunction(address, sema.AuthAccountTypeTypeFunctionType)
 		case sema.AuthAccountLoadField:
 			return inter.authAccountLoadFunction(address)
 		case sema.AuthAccountCopyField:
@@ -200,6 +200,8 @@ func NewPublicAccountValue(
 			return storageCapacityGet(inter)
 		case sema.PublicAccountGetTargetLinkField:
 			return inter.accountGetLinkTargetFunction(address)
+		case sema.PublicAccountTypeField:
+			return inter.accountTypeFunction(address, sema.PublicAccountTypeTypeFunctionType)
 		}
 
 		return nil
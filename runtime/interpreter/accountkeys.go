@@ -77,10 +77,21 @@ func NewPublicAccountKeysValue(
 	gauge common.MemoryGauge,
 	address AddressValue,
 	getFunction FunctionValue,
+	forEachFunction FunctionValue,
+	getKeysCount func() UInt64Value,
 ) Value {
 
 	fields := map[string]Value{
-		sema.AccountKeysGetFunctionName: getFunction,
+		sema.AccountKeysGetFunctionName:           getFunction,
+		sema.PublicAccountKeysForEachFunctionName: forEachFunction,
+	}
+
+	computeField := func(name string, _ *Interpreter, _ func() LocationRange) Value {
+		switch name {
+		case sema.PublicAccountKeysCountField:
+			return getKeysCount()
+		}
+		return nil
 	}
 
 	var str string
@@ -99,7 +110,7 @@ func NewPublicAccountKeysValue(
 		publicAccountKeysStaticType,
 		nil,
 		fields,
-		nil,
+		computeField,
 		nil,
 		stringer,
 	)
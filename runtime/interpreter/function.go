@@ -80,6 +80,7 @@ func NewInterpretedFunctionValue(
 }
 
 var _ Value = &InterpretedFunctionValue{}
+var _ EquatableValue = &InterpretedFunctionValue{}
 
 func (*InterpretedFunctionValue) IsValue() {}
 
@@ -132,6 +133,30 @@ func (f *InterpretedFunctionValue) ConformsToStaticType(
 	return true
 }
 
+// Equal returns true if the other value is an interpreted function
+// of the same type, declaration, and lexical scope.
+//
+// The declaration is identified by its body's statements.
+// Functions with an empty body cannot be told apart,
+// so they are only equal to themselves.
+//
+func (f *InterpretedFunctionValue) Equal(_ *Interpreter, _ func() LocationRange, other Value) bool {
+	otherFunction, ok := other.(*InterpretedFunctionValue)
+	if !ok {
+		return false
+	}
+
+	if f == otherFunction {
+		return true
+	}
+
+	return len(f.Statements) > 0 &&
+		len(f.Statements) == len(otherFunction.Statements) &&
+		&f.Statements[0] == &otherFunction.Statements[0] &&
+		f.Activation == otherFunction.Activation &&
+		f.Type.Equal(otherFunction.Type)
+}
+
 func (f *InterpretedFunctionValue) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
 	return NonStorable{Value: f}, nil
 }
@@ -171,6 +196,9 @@ func (*InterpretedFunctionValue) DeepRemove(_ *Interpreter) {
 type HostFunction func(invocation Invocation) Value
 
 type HostFunctionValue struct {
+	// Name is optional, and only used for debugging purposes,
+	// e.g. in the string representation of the function
+	Name            string
 	Function        HostFunction
 	NestedVariables map[string]*Variable
 	Type            *sema.FunctionType
@@ -178,6 +206,9 @@ type HostFunctionValue struct {
 
 func (f *HostFunctionValue) String() string {
 	// TODO: include type
+	if f.Name != "" {
+		return format.NamedHostFunction(f.Name)
+	}
 	return format.HostFunction
 }
 
@@ -186,6 +217,11 @@ func (f *HostFunctionValue) RecursiveString(_ SeenReferences) string {
 }
 
 func (f *HostFunctionValue) MeteredString(memoryGauge common.MemoryGauge, _ SeenReferences) string {
+	if f.Name != "" {
+		str := f.String()
+		common.UseMemory(memoryGauge, common.NewRawStringMemoryUsage(len(str)))
+		return str
+	}
 	common.UseMemory(memoryGauge, common.HostFunctionValueStringMemoryUsage)
 	return f.String()
 }
@@ -218,8 +254,37 @@ func NewHostFunctionValue(
 	return NewUnmeteredHostFunctionValue(function, funcType)
 }
 
+// NewUnmeteredNamedHostFunctionValue constructs a host function value with the given name.
+// The name is used in the string representation of the function,
+// which makes e.g. built-in functions identifiable when debugging.
+//
+func NewUnmeteredNamedHostFunctionValue(
+	name string,
+	function HostFunction,
+	funcType *sema.FunctionType,
+) *HostFunctionValue {
+	functionValue := NewUnmeteredHostFunctionValue(function, funcType)
+	functionValue.Name = name
+	return functionValue
+}
+
+// NewNamedHostFunctionValue is the metered version of NewUnmeteredNamedHostFunctionValue.
+//
+func NewNamedHostFunctionValue(
+	gauge common.MemoryGauge,
+	name string,
+	function HostFunction,
+	funcType *sema.FunctionType,
+) *HostFunctionValue {
+
+	common.UseMemory(gauge, common.HostFunctionValueMemoryUsage)
+
+	return NewUnmeteredNamedHostFunctionValue(name, function, funcType)
+}
+
 var _ Value = &HostFunctionValue{}
 var _ MemberAccessibleValue = &HostFunctionValue{}
+var _ EquatableValue = &HostFunctionValue{}
 
 func (*HostFunctionValue) IsValue() {}
 
@@ -276,6 +341,18 @@ func (f *HostFunctionValue) ConformsToStaticType(
 	return true
 }
 
+// Equal returns true if the other value is the same host function.
+// Host functions are opaque, so they can only be compared by identity.
+//
+func (f *HostFunctionValue) Equal(_ *Interpreter, _ func() LocationRange, other Value) bool {
+	otherFunction, ok := other.(*HostFunctionValue)
+	if !ok {
+		return false
+	}
+
+	return f == otherFunction
+}
+
 func (f *HostFunctionValue) Storable(_ atree.SlabStorage, _ atree.Address, _ uint64) (atree.Storable, error) {
 	return NonStorable{Value: f}, nil
 }
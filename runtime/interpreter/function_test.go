@@ -23,6 +23,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/onflow/cadence/runtime/ast"
 	"github.com/onflow/cadence/runtime/common"
 	"github.com/onflow/cadence/runtime/tests/utils"
 
@@ -100,3 +101,134 @@ func TestFunctionStaticType(t *testing.T) {
 		assert.Equal(t, hostFunctionValue.StaticType(inter), staticType)
 	})
 }
+
+func TestFunctionEquality(t *testing.T) {
+
+	t.Parallel()
+
+	functionType := &sema.FunctionType{
+		Parameters:           []*sema.Parameter{},
+		ReturnTypeAnnotation: sema.NewTypeAnnotation(sema.BoolType),
+	}
+
+	t.Run("HostFunctionValue", func(t *testing.T) {
+		t.Parallel()
+
+		inter := newTestInterpreter(t)
+
+		hostFunction := func(_ Invocation) Value {
+			return NewBoolValue(inter, true)
+		}
+
+		hostFunctionValue := NewHostFunctionValue(inter, hostFunction, functionType)
+		otherHostFunctionValue := NewHostFunctionValue(inter, hostFunction, functionType)
+
+		var value Value = hostFunctionValue
+
+		assert.True(t, hostFunctionValue.Equal(inter, ReturnEmptyLocationRange, value))
+		assert.False(t, hostFunctionValue.Equal(inter, ReturnEmptyLocationRange, otherHostFunctionValue))
+		assert.False(t, hostFunctionValue.Equal(inter, ReturnEmptyLocationRange, NewBoolValue(inter, true)))
+
+		assert.Equal(t, "Function(...)", hostFunctionValue.String())
+		assert.Equal(t, hostFunctionValue.String(), otherHostFunctionValue.String())
+	})
+
+	t.Run("InterpretedFunctionValue", func(t *testing.T) {
+		t.Parallel()
+
+		inter := newTestInterpreter(t)
+
+		activation := NewVariableActivation(inter, nil)
+
+		newFunction := func(statements []ast.Statement) *InterpretedFunctionValue {
+			return NewInterpretedFunctionValue(
+				inter,
+				nil,
+				functionType,
+				activation,
+				nil,
+				nil,
+				statements,
+				nil,
+			)
+		}
+
+		statements := []ast.Statement{
+			&ast.ReturnStatement{},
+		}
+		otherStatements := []ast.Statement{
+			&ast.ReturnStatement{},
+		}
+
+		functionValue := newFunction(statements)
+
+		// same declaration
+		assert.True(t, functionValue.Equal(inter, ReturnEmptyLocationRange, functionValue))
+		assert.True(t, functionValue.Equal(inter, ReturnEmptyLocationRange, newFunction(statements)))
+
+		// different declarations, both without parameters, in the same scope
+		assert.False(t, functionValue.Equal(inter, ReturnEmptyLocationRange, newFunction(otherStatements)))
+
+		// empty bodies
+		emptyFunctionValue := newFunction(nil)
+		assert.True(t, emptyFunctionValue.Equal(inter, ReturnEmptyLocationRange, emptyFunctionValue))
+		assert.False(t, emptyFunctionValue.Equal(inter, ReturnEmptyLocationRange, newFunction(nil)))
+
+		assert.Equal(t, "Function((): Bool)", functionValue.String())
+	})
+}
+
+func TestNamedHostFunctionValue(t *testing.T) {
+
+	t.Parallel()
+
+	inter := newTestInterpreter(t)
+
+	hostFunction := func(_ Invocation) Value {
+		return NewBoolValue(inter, true)
+	}
+
+	hostFunctionType := &sema.FunctionType{
+		Parameters:           []*sema.Parameter{},
+		ReturnTypeAnnotation: sema.NewTypeAnnotation(sema.BoolType),
+	}
+
+	hostFunctionValue := NewNamedHostFunctionValue(
+		inter,
+		"foo",
+		hostFunction,
+		hostFunctionType,
+	)
+
+	assert.Equal(t, "foo", hostFunctionValue.Name)
+	assert.Equal(t, "Function foo(...)", hostFunctionValue.String())
+
+	meteredRawStringAmount := func(functionValue *HostFunctionValue) (string, uint64) {
+		var amount uint64
+		gauge := testMemoryGaugeFunc(func(usage common.MemoryUsage) error {
+			if usage.Kind == common.MemoryKindRawString {
+				amount += usage.Amount
+			}
+			return nil
+		})
+		return functionValue.MeteredString(gauge, SeenReferences{}), amount
+	}
+
+	// named host functions are metered for the length of their name
+
+	str, amount := meteredRawStringAmount(hostFunctionValue)
+	assert.Equal(t, "Function foo(...)", str)
+	assert.Equal(t, common.NewRawStringMemoryUsage(len("Function foo(...)")).Amount, amount)
+
+	// unnamed host functions are metered for the fixed string
+
+	str, amount = meteredRawStringAmount(NewUnmeteredHostFunctionValue(hostFunction, hostFunctionType))
+	assert.Equal(t, "Function(...)", str)
+	assert.Equal(t, common.HostFunctionValueStringMemoryUsage.Amount, amount)
+}
+
+type testMemoryGaugeFunc func(usage common.MemoryUsage) error
+
+func (f testMemoryGaugeFunc) MeterMemory(usage common.MemoryUsage) error {
+	return f(usage)
+}
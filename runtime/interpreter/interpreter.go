@@ -3286,7 +3286,10 @@ func (interpreter *Interpreter) authAccountSaveFunction(addressValue AddressValu
 	)
 }
 
-func (interpreter *Interpreter) authAccountTypeFunction(addressValue AddressValue) *HostFunctionValue {
+func (interpreter *Interpreter) accountTypeFunction(
+	addressValue AddressValue,
+	functionType *sema.FunctionType,
+) *HostFunctionValue {
 
 	// Converted addresses can be cached and don't have to be recomputed on each function invocation
 	address := addressValue.ToAddress()
@@ -3317,7 +3320,7 @@ func (interpreter *Interpreter) authAccountTypeFunction(addressValue AddressValu
 			)
 		},
 
-		sema.AuthAccountTypeTypeFunctionType,
+		functionType,
 	)
 }
 
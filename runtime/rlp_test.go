@@ -55,7 +55,7 @@ func TestRLPDecodeString(t *testing.T) {
 			name:           "empty input",
 			input:          []cadence.Value{},
 			output:         nil,
-			expectedErrMsg: "failed to RLP-decode string: input data is empty",
+			expectedErrMsg: "failed to RLP-decode string: input data is empty (at offset 0)",
 		},
 		{
 			name: "empty string",
@@ -114,7 +114,7 @@ func TestRLPDecodeString(t *testing.T) {
 				cadence.UInt8(131),
 			},
 			output:         nil,
-			expectedErrMsg: "failed to RLP-decode string: incomplete input! not enough bytes to read",
+			expectedErrMsg: "failed to RLP-decode string: incomplete input! not enough bytes to read (at offset 0)",
 		},
 	}
 
@@ -197,7 +197,7 @@ func TestRLPDecodeList(t *testing.T) {
 			name:           "empty input",
 			input:          []cadence.Value{},
 			output:         nil,
-			expectedErrMsg: "failed to RLP-decode list: input data is empty",
+			expectedErrMsg: "failed to RLP-decode list: input data is empty (at offset 0)",
 		},
 		{
 			name: "empty list",
@@ -219,6 +219,18 @@ func TestRLPDecodeList(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "handling lower level errors - incomplete item",
+			input: []cadence.Value{
+				cadence.UInt8(0xc3),
+				cadence.UInt8(0x41),
+				// truncated long string header
+				cadence.UInt8(0xb9),
+				cadence.UInt8(0x01),
+			},
+			output:         nil,
+			expectedErrMsg: "failed to RLP-decode list: incomplete input! not enough bytes to read (at offset 2)",
+		},
 		{
 			name: "single element list with trailing extra bytes",
 			input: []cadence.Value{
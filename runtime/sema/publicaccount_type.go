@@ -30,6 +30,7 @@ const PublicAccountStorageUsedField = "storageUsed"
 const PublicAccountStorageCapacityField = "storageCapacity"
 const PublicAccountGetCapabilityField = "getCapability"
 const PublicAccountGetTargetLinkField = "getLinkTarget"
+const PublicAccountTypeField = "type"
 const PublicAccountForEachPublicField = "forEachPublic"
 const PublicAccountKeysField = "keys"
 const PublicAccountContractsField = "contracts"
@@ -95,6 +96,12 @@ var PublicAccountType = func() *CompositeType {
 			AccountTypeGetLinkTargetFunctionType,
 			accountTypeGetLinkTargetFunctionDocString,
 		),
+		NewUnmeteredPublicFunctionMember(
+			publicAccountType,
+			PublicAccountTypeField,
+			PublicAccountTypeTypeFunctionType,
+			publicAccountTypeTypeFunctionDocString,
+		),
 		NewUnmeteredPublicConstantFieldMember(
 			publicAccountType,
 			PublicAccountKeysField,
@@ -173,6 +180,9 @@ false will abort iteration
 
 var PublicAccountForEachPublicFunctionType = AccountForEachFunctionType(PublicPathType)
 
+const PublicAccountKeysForEachFunctionName = "forEach"
+const PublicAccountKeysCountField = "count"
+
 // PublicAccountKeysType represents the keys associated with a public account.
 var PublicAccountKeysType = func() *CompositeType {
 
@@ -189,6 +199,18 @@ var PublicAccountKeysType = func() *CompositeType {
 			AccountKeysTypeGetFunctionType,
 			accountKeysTypeGetFunctionDocString,
 		),
+		NewUnmeteredPublicFunctionMember(
+			accountKeys,
+			PublicAccountKeysForEachFunctionName,
+			PublicAccountKeysTypeForEachFunctionType,
+			publicAccountKeysTypeForEachFunctionDocString,
+		),
+		NewUnmeteredPublicConstantFieldMember(
+			accountKeys,
+			PublicAccountKeysCountField,
+			UInt64Type,
+			publicAccountKeysTypeCountFieldDocString,
+		),
 	}
 
 	accountKeys.Members = GetMembersAsMap(members)
@@ -201,6 +223,43 @@ func init() {
 	PublicAccountKeysType.SetContainerType(PublicAccountType)
 }
 
+const publicAccountKeysTypeForEachFunctionDocString = `
+Iterate over all the keys of the account, including revoked keys.
+
+Takes one argument: the key.
+
+Returns a bool indicating whether the iteration should continue; true will continue iterating onto the next key,
+false will abort iteration
+`
+
+var PublicAccountKeysTypeForEachFunctionType = func() *FunctionType {
+	iterFunctionType := &FunctionType{
+		Parameters: []*Parameter{
+			{
+				Label:          ArgumentLabelNotRequired,
+				Identifier:     "key",
+				TypeAnnotation: NewTypeAnnotation(AccountKeyType),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(BoolType),
+	}
+
+	return &FunctionType{
+		Parameters: []*Parameter{
+			{
+				Label:          ArgumentLabelNotRequired,
+				Identifier:     "function",
+				TypeAnnotation: NewTypeAnnotation(iterFunctionType),
+			},
+		},
+		ReturnTypeAnnotation: NewTypeAnnotation(VoidType),
+	}
+}()
+
+const publicAccountKeysTypeCountFieldDocString = `
+The number of keys of the account, including revoked keys
+`
+
 var PublicAccountTypeGetCapabilityFunctionType = func() *FunctionType {
 
 	typeParameter := &TypeParameter{
@@ -235,3 +294,26 @@ var PublicAccountTypeGetCapabilityFunctionType = func() *FunctionType {
 const publicAccountTypeGetLinkTargetFunctionDocString = `
 Returns the capability at the given public path, or nil if it does not exist
 `
+
+const publicAccountTypeTypeFunctionDocString = `
+Returns the type of the link stored under the given public path, or nil if nothing is stored under the given path.
+
+A public path only ever holds a link, so the result is the link's capability type. The link is not borrowed.
+
+The path must be a public path, i.e., only the domain ` + "`public`" + ` is allowed
+`
+
+var PublicAccountTypeTypeFunctionType = &FunctionType{
+	Parameters: []*Parameter{
+		{
+			Label:          "at",
+			Identifier:     "path",
+			TypeAnnotation: NewTypeAnnotation(PublicPathType),
+		},
+	},
+	ReturnTypeAnnotation: NewTypeAnnotation(
+		&OptionalType{
+			Type: MetaType,
+		},
+	),
+}
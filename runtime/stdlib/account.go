@@ -763,9 +763,136 @@ func newPublicAccountKeysValue(
 			handler,
 			addressValue,
 		),
+		newAccountKeysForEachFunction(
+			gauge,
+			handler,
+			addressValue,
+		),
+		newAccountKeysCountGetter(
+			gauge,
+			handler,
+			addressValue,
+		),
 	)
 }
 
+// NOTE: the host environment only provides access to single keys by index,
+// and returns a nil key if there is no key at the given index.
+// Keys are never removed, only revoked, so the keys of an account
+// can be found by reading keys at increasing indices until no key is found.
+
+func newAccountKeysForEachFunction(
+	gauge common.MemoryGauge,
+	provider AccountKeyProvider,
+	addressValue interpreter.AddressValue,
+) *interpreter.HostFunctionValue {
+
+	// Converted addresses can be cached and don't have to be recomputed on each function invocation
+	address := addressValue.ToAddress()
+
+	return interpreter.NewHostFunctionValue(
+		gauge,
+		func(invocation interpreter.Invocation) interpreter.Value {
+			fnValue, ok := invocation.Arguments[0].(interpreter.FunctionValue)
+			if !ok {
+				panic(errors.NewUnreachableError())
+			}
+
+			inter := invocation.Interpreter
+			getLocationRange := invocation.GetLocationRange
+
+			argumentTypes := []sema.Type{sema.AccountKeyType}
+
+			for index := 0; ; index++ {
+
+				var err error
+				var accountKey *AccountKey
+				wrapPanic(func() {
+					accountKey, err = provider.GetAccountKey(address, index)
+				})
+
+				if err != nil {
+					panic(err)
+				}
+
+				if accountKey == nil {
+					break
+				}
+
+				accountKeyValue := NewAccountKeyValue(
+					inter,
+					getLocationRange,
+					accountKey,
+					// public keys are assumed to be already validated.
+					func(
+						_ *interpreter.Interpreter,
+						_ func() interpreter.LocationRange,
+						_ *interpreter.CompositeValue,
+					) error {
+						return nil
+					},
+				)
+
+				result, err := inter.InvokeFunctionValue(
+					fnValue,
+					[]interpreter.Value{accountKeyValue},
+					argumentTypes,
+					argumentTypes,
+					getLocationRange(),
+				)
+				if err != nil {
+					panic(err)
+				}
+
+				shouldContinue, ok := result.(interpreter.BoolValue)
+				if !ok {
+					panic(errors.NewUnreachableError())
+				}
+
+				if !shouldContinue {
+					break
+				}
+			}
+
+			return interpreter.NewVoidValue(inter)
+		},
+		sema.PublicAccountKeysTypeForEachFunctionType,
+	)
+}
+
+func newAccountKeysCountGetter(
+	gauge common.MemoryGauge,
+	provider AccountKeyProvider,
+	addressValue interpreter.AddressValue,
+) func() interpreter.UInt64Value {
+
+	// Converted addresses can be cached and don't have to be recomputed on each function invocation
+	address := addressValue.ToAddress()
+
+	return func() interpreter.UInt64Value {
+		return interpreter.NewUInt64Value(gauge, func() uint64 {
+			var count int
+			for ; ; count++ {
+				var err error
+				var accountKey *AccountKey
+				wrapPanic(func() {
+					accountKey, err = provider.GetAccountKey(address, count)
+				})
+
+				if err != nil {
+					panic(err)
+				}
+
+				if accountKey == nil {
+					break
+				}
+			}
+
+			return uint64(count)
+		})
+	}
+}
+
 type PublicAccountContractsHandler interface {
 	AccountContractNamesProvider
 	AccountContractProvider
@@ -53,7 +53,7 @@ var assertFunctionType = &sema.FunctionType{
 	RequiredArgumentCount: sema.RequiredArgumentCount(1),
 }
 
-var AssertFunction = NewStandardLibraryFunction(
+var AssertFunction = NewNamedStandardLibraryFunction(
 	"assert",
 	assertFunctionType,
 	assertFunctionDocString,
@@ -97,5 +97,5 @@ func (e AssertionError) Error() string {
 	if e.Message == "" {
 		return message
 	}
-	return fmt.Sprintf("%s: %s", message, e.Message)
+	return fmt.Sprintf("%s: %q", message, e.Message)
 }
@@ -19,6 +19,7 @@
 package stdlib
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -125,6 +126,32 @@ func TestAssert(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestAssertionError(t *testing.T) {
+
+	t.Parallel()
+
+	inter := testInterpreter(t,
+		`pub let test = assert`,
+		AssertFunction,
+	)
+
+	_, err := inter.Invoke(
+		"test",
+		interpreter.BoolValue(false),
+		interpreter.NewUnmeteredStringValue("oops"),
+	)
+	require.Error(t, err)
+
+	var assertionErr AssertionError
+	require.True(t, errors.As(err, &assertionErr))
+	assert.Equal(t, "oops", assertionErr.Message)
+
+	assert.EqualError(t, err, `assertion failed: "oops"`)
+
+	_, err = inter.Invoke("test", interpreter.BoolValue(false))
+	assert.EqualError(t, err, "assertion failed")
+}
+
 func TestPanic(t *testing.T) {
 
 	t.Parallel()
@@ -145,3 +172,14 @@ func TestPanic(t *testing.T) {
 		err,
 	)
 }
+
+func TestStandardLibraryFunctionName(t *testing.T) {
+
+	t.Parallel()
+
+	assert.Equal(t, "Function assert(...)", AssertFunction.Value.String())
+	assert.Equal(t, "Function panic(...)", PanicFunction.Value.String())
+
+	// other standard library functions are not named
+	assert.Equal(t, "Function(...)", NewLogFunction(nil).Value.String())
+}
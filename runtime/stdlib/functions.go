@@ -30,6 +30,30 @@ func NewStandardLibraryFunction(
 	docString string,
 	function interpreter.HostFunction,
 ) StandardLibraryValue {
+	functionValue := interpreter.NewUnmeteredHostFunctionValue(function, functionType)
+	return newStandardLibraryFunction(name, functionType, docString, functionValue)
+}
+
+// NewNamedStandardLibraryFunction is like NewStandardLibraryFunction,
+// but the function value also carries the name,
+// so it is shown in the function's string representation.
+//
+func NewNamedStandardLibraryFunction(
+	name string,
+	functionType *sema.FunctionType,
+	docString string,
+	function interpreter.HostFunction,
+) StandardLibraryValue {
+	functionValue := interpreter.NewUnmeteredNamedHostFunctionValue(name, function, functionType)
+	return newStandardLibraryFunction(name, functionType, docString, functionValue)
+}
+
+func newStandardLibraryFunction(
+	name string,
+	functionType *sema.FunctionType,
+	docString string,
+	functionValue *interpreter.HostFunctionValue,
+) StandardLibraryValue {
 
 	parameters := functionType.Parameters
 
@@ -39,8 +63,6 @@ func NewStandardLibraryFunction(
 		argumentLabels[i] = parameter.EffectiveArgumentLabel()
 	}
 
-	functionValue := interpreter.NewUnmeteredHostFunctionValue(function, functionType)
-
 	return StandardLibraryValue{
 		Name:           name,
 		Type:           functionType,
@@ -56,7 +56,7 @@ var panicFunctionType = &sema.FunctionType{
 	),
 }
 
-var PanicFunction = NewStandardLibraryFunction(
+var PanicFunction = NewNamedStandardLibraryFunction(
 	"panic",
 	panicFunctionType,
 	panicFunctionDocString,
@@ -21,6 +21,7 @@ package rlp
 import (
 	"encoding/binary"
 	"errors"
+	"fmt"
 	"math"
 )
 
@@ -47,8 +48,96 @@ var (
 	ErrDataSizeTooLarge  = errors.New("data size is larger than what is supported")
 	ErrListSizeMismatch  = errors.New("list size doesn't match the size of items")
 	ErrTypeMismatch      = errors.New("type extracted from input doesn't match the function")
+	ErrTooManyListItems  = errors.New("list contains more items than what is supported")
 )
 
+// DecodeError is returned by DecodeString and DecodeList.
+// Offset is the position in the input of the item that failed to decode.
+type DecodeError struct {
+	Offset int
+	Err    error
+}
+
+func newDecodeError(offset int, err error) DecodeError {
+	return DecodeError{
+		Offset: offset,
+		Err:    err,
+	}
+}
+
+func (e DecodeError) Error() string {
+	return fmt.Sprintf("%s (at offset %d)", e.Err.Error(), e.Offset)
+}
+
+func (e DecodeError) Unwrap() error {
+	return e.Err
+}
+
+// Limits are the bounds a Decoder enforces on its input.
+type Limits struct {
+	// MaxDataSize is the maximum number of data bytes of a single string or list
+	MaxDataSize int
+	// MaxListItemCount is the maximum number of items of a single list
+	MaxListItemCount int
+}
+
+const (
+	DefaultMaxDataSize      = MaxLongLengthAllowed
+	DefaultMaxListItemCount = math.MaxInt
+)
+
+// DefaultLimits returns the limits used by the package-level decoding functions
+func DefaultLimits() Limits {
+	return Limits{
+		MaxDataSize:      DefaultMaxDataSize,
+		MaxListItemCount: DefaultMaxListItemCount,
+	}
+}
+
+// Decoder decodes RLP-encoded input within the given limits.
+// The default limits are the largest sizes the decoder supports,
+// callers in constrained environments may lower them.
+//
+// Note that the zero value of Limits, and so also the zero value of Decoder,
+// only accepts empty strings and empty lists.
+type Decoder struct {
+	Limits Limits
+}
+
+// NewDecoder returns a decoder for the given limits.
+// Negative limits are treated as zero.
+func NewDecoder(limits Limits) Decoder {
+	if limits.MaxDataSize < 0 {
+		limits.MaxDataSize = 0
+	}
+	if limits.MaxListItemCount < 0 {
+		limits.MaxListItemCount = 0
+	}
+	return Decoder{
+		Limits: limits,
+	}
+}
+
+// exceedsMaxDataSize returns true if the given data size is larger than the data size limit
+func (d Decoder) exceedsMaxDataSize(size uint) bool {
+	return size > MaxLongLengthAllowed || int(size) > d.Limits.MaxDataSize
+}
+
+// ReadSize reads the size of the item at startIndex using the default limits, see Decoder.ReadSize
+func ReadSize(inp []byte, startIndex int) (isString bool, dataStartIndex, dataSize int, err error) {
+	return NewDecoder(DefaultLimits()).ReadSize(inp, startIndex)
+}
+
+// DecodeString decodes a string using the default limits, see Decoder.DecodeString
+func DecodeString(inp []byte, startIndex int) (str []byte, bytesRead int, err error) {
+	return NewDecoder(DefaultLimits()).DecodeString(inp, startIndex)
+}
+
+// DecodeList decodes a list using the default limits, see Decoder.DecodeList
+func DecodeList(inp []byte, startIndex int) (encodedItems [][]byte, bytesRead int, err error) {
+	return NewDecoder(DefaultLimits()).DecodeList(inp, startIndex)
+}
+
 // ReadSize looks at the first byte at startIndex to decode the type and reads as many bytes as needed
 // to determine the data byte size, it returns a flag if the type is string, start index of data part in the input,
 // number of bytes that has to be read for data (from start index of data) and error if any.
@@ -59,7 +148,9 @@ var (
 //   - if string is more than 55 bytes long (first byte is [0xb8, 0xbf]), string length can't be encoded with leading 0s
 //   - if list payload is more than 55 bytes long (first byte is [0xf8, 0xff]), list payload length can't be <= 55
 //   - if list payload is more than 55 bytes long (first byte is [0xf8, 0xff]), list payload length can't be encoded with leading 0s
-func ReadSize(inp []byte, startIndex int) (isString bool, dataStartIndex, dataSize int, err error) {
+//
+// it returns ErrDataSizeTooLarge if the data size exceeds the decoder's MaxDataSize limit.
+func (d Decoder) ReadSize(inp []byte, startIndex int) (isString bool, dataStartIndex, dataSize int, err error) {
 	if len(inp) == 0 {
 		return false, 0, 0, ErrEmptyInput
 	}
@@ -74,6 +165,9 @@ func ReadSize(inp []byte, startIndex int) (isString bool, dataStartIndex, dataSi
 
 	// single character space - first byte holds the data itslef
 	if firstByte <= ByteRangeEnd {
+		if d.exceedsMaxDataSize(1) {
+			return false, 0, 0, ErrDataSizeTooLarge
+		}
 		return true, startIndex - 1, 1, nil
 	}
 
@@ -82,6 +176,9 @@ func ReadSize(inp []byte, startIndex int) (isString bool, dataStartIndex, dataSi
 	// valid range of firstByte is [0x80, 0xB7].
 	if firstByte <= ShortStringRangeEnd {
 		strLen := uint(firstByte - ShortStringRangeStart)
+		if d.exceedsMaxDataSize(strLen) {
+			return false, 0, 0, ErrDataSizeTooLarge
+		}
 		return true, startIndex, int(strLen), nil
 	}
 
@@ -89,6 +186,9 @@ func ReadSize(inp []byte, startIndex int) (isString bool, dataStartIndex, dataSi
 	// firstByte minus the start range for the short list would return the data size
 	if firstByte >= ShortListRangeStart && firstByte <= ShortListRangeEnd {
 		strLen := uint(firstByte - ShortListRangeStart)
+		if d.exceedsMaxDataSize(strLen) {
+			return false, 0, 0, ErrDataSizeTooLarge
+		}
 		return false, startIndex, int(strLen), nil
 	}
 
@@ -126,6 +226,9 @@ func ReadSize(inp []byte, startIndex int) (isString bool, dataStartIndex, dataSi
 			// should have encoded as a short string
 			return false, 0, 0, ErrNonCanonicalInput
 		}
+		if d.exceedsMaxDataSize(strLen) {
+			return false, 0, 0, ErrDataSizeTooLarge
+		}
 		return isString, startIndex, int(strLen), nil
 	}
 
@@ -155,7 +258,7 @@ func ReadSize(inp []byte, startIndex int) (isString bool, dataStartIndex, dataSi
 
 	// no need to check strLen <= MaxShortLengthAllowed since bytesToReadForLen is at least 2 here
 	// and can not contain leading zero byte.
-	if strLen > MaxLongLengthAllowed {
+	if d.exceedsMaxDataSize(strLen) {
 		return false, 0, 0, ErrDataSizeTooLarge
 	}
 	return isString, startIndex, int(strLen), nil
@@ -163,15 +266,15 @@ func ReadSize(inp []byte, startIndex int) (isString bool, dataStartIndex, dataSi
 
 // DecodeString decodes a RLP-encoded string given the startIndex
 // it returns decoded string, number of bytes that were read and err if any
-func DecodeString(inp []byte, startIndex int) (str []byte, bytesRead int, err error) {
+func (d Decoder) DecodeString(inp []byte, startIndex int) (str []byte, bytesRead int, err error) {
 	// read data size info
-	isString, dataStartIndex, dataSize, err := ReadSize(inp, startIndex)
+	isString, dataStartIndex, dataSize, err := d.ReadSize(inp, startIndex)
 	if err != nil {
-		return nil, 0, err
+		return nil, 0, newDecodeError(startIndex, err)
 	}
 	// check type
 	if !isString {
-		return nil, 0, ErrTypeMismatch
+		return nil, 0, newDecodeError(startIndex, ErrTypeMismatch)
 	}
 
 	// single character special case
@@ -179,33 +282,34 @@ func DecodeString(inp []byte, startIndex int) (str []byte, bytesRead int, err er
 		return []byte{inp[dataStartIndex]}, 1, nil
 	}
 
+	dataEndIndex := dataStartIndex + dataSize
+	if dataEndIndex > len(inp) {
+		return nil, 0, newDecodeError(startIndex, ErrIncompleteInput)
+	}
+
 	// if for data we have to read only a single extra byte and that byte
 	// is in the range of characters, we are using two bytes instead of 1 byte
 	if dataSize == 1 && inp[dataStartIndex] <= ByteRangeEnd {
-		return nil, 0, ErrNonCanonicalInput
+		return nil, 0, newDecodeError(startIndex, ErrNonCanonicalInput)
 	}
 
 	// collect and return string
-	dataEndIndex := dataStartIndex + dataSize
-	if dataEndIndex > len(inp) {
-		return nil, 0, ErrIncompleteInput
-	}
-
 	return inp[dataStartIndex:dataEndIndex], dataEndIndex - startIndex, nil
 }
 
 // DecodeList decodes a RLP-encoded list given the startIndex
 // it returns a list of encodedItems, number of bytes that were read and err if any
-func DecodeList(inp []byte, startIndex int) (encodedItems [][]byte, bytesRead int, err error) {
+// it returns ErrTooManyListItems if the list has more items than the decoder's MaxListItemCount limit.
+func (d Decoder) DecodeList(inp []byte, startIndex int) (encodedItems [][]byte, bytesRead int, err error) {
 	// read data size info
-	isString, dataStartIndex, listDataSize, err := ReadSize(inp, startIndex)
+	isString, dataStartIndex, listDataSize, err := d.ReadSize(inp, startIndex)
 	if err != nil {
-		return nil, 0, err
+		return nil, 0, newDecodeError(startIndex, err)
 	}
 
 	// check type
 	if isString {
-		return nil, 0, ErrTypeMismatch
+		return nil, 0, newDecodeError(startIndex, ErrTypeMismatch)
 	}
 
 	retList := make([][]byte, 0)
@@ -216,28 +320,40 @@ func DecodeList(inp []byte, startIndex int) (encodedItems [][]byte, bytesRead in
 	}
 
 	if listDataSize+dataStartIndex > len(inp) {
-		return nil, 0, ErrIncompleteInput
+		return nil, 0, newDecodeError(startIndex, ErrIncompleteInput)
 	}
 
 	var itemStartIndex, itemEndIndex, dataBytesRead int
 	itemStartIndex = dataStartIndex
 
 	for dataBytesRead < listDataSize {
-		_, itemDataStartIndex, itemSize, err := ReadSize(inp, itemStartIndex)
+		if len(retList) >= d.Limits.MaxListItemCount {
+			return nil, 0, newDecodeError(itemStartIndex, ErrTooManyListItems)
+		}
+		isItemString, itemDataStartIndex, itemSize, err := d.ReadSize(inp, itemStartIndex)
 		if err != nil {
-			return nil, 0, err
+			return nil, 0, newDecodeError(itemStartIndex, err)
 		}
 		// collect encoded item
 		itemEndIndex = itemDataStartIndex + itemSize
 		if itemEndIndex > len(inp) {
-			return nil, 0, ErrIncompleteInput
+			return nil, 0, newDecodeError(itemStartIndex, ErrIncompleteInput)
+		}
+		// a single character item must be encoded as itself,
+		// not as a short string (same as in DecodeString)
+		if isItemString &&
+			itemSize == 1 &&
+			itemDataStartIndex != itemStartIndex &&
+			inp[itemDataStartIndex] <= ByteRangeEnd {
+
+			return nil, 0, newDecodeError(itemStartIndex, ErrNonCanonicalInput)
 		}
 		retList = append(retList, inp[itemStartIndex:itemEndIndex])
 		dataBytesRead += itemEndIndex - itemStartIndex
 		itemStartIndex = itemEndIndex
 	}
 	if dataBytesRead != listDataSize {
-		return nil, 0, ErrListSizeMismatch
+		return nil, 0, newDecodeError(startIndex, ErrListSizeMismatch)
 	}
 
 	return retList, itemEndIndex - startIndex, nil
@@ -151,6 +151,26 @@ func TestDecodeString(t *testing.T) {
 			[]byte{0x81, 0x01},
 			rlp.ErrNonCanonicalInput,
 		},
+		{ // single character encoded as itself
+			[]byte{0x05},
+			[]byte{0x05},
+			nil,
+		},
+		{ // short string but a single character encoded inside
+			nil,
+			[]byte{0x81, 0x05},
+			rlp.ErrNonCanonicalInput,
+		},
+		{ // short string with a single byte above the single character range
+			[]byte{0x80},
+			[]byte{0x81, 0x80},
+			nil,
+		},
+		{ // short string header without the data byte
+			nil,
+			[]byte{0x81},
+			rlp.ErrIncompleteInput,
+		},
 		{
 			[]byte("dog"),
 			[]byte{0x83, 0x64, 0x6f, 0x67},
@@ -254,7 +274,7 @@ func TestDecodeString(t *testing.T) {
 	for _, test := range tests {
 		item, bytesRead, err := rlp.DecodeString(test.encoded, 0)
 		if test.expectedErr != nil {
-			require.Equal(t, test.expectedErr, err)
+			require.ErrorIs(t, err, test.expectedErr)
 		} else {
 			require.NoError(t, err)
 			require.Equal(t, item, test.expectedOutput)
@@ -299,6 +319,11 @@ func TestDecodeList(t *testing.T) {
 			[]byte{0xc1, 0x41},
 			nil,
 		},
+		{
+			nil, // single character item encoded as a short string
+			[]byte{0xc2, 0x81, 0x05},
+			rlp.ErrNonCanonicalInput,
+		},
 		{
 			[][]byte{{0x41}, {0xc1, 0x42}, {0x82, 0x41, 0x42}}, // mixed encoded values
 			[]byte{0xc6, 0x41, 0xc1, 0x42, 0x82, 0x41, 0x42},
@@ -442,7 +467,7 @@ func TestDecodeList(t *testing.T) {
 	for _, test := range tests {
 		item, bytesRead, err := rlp.DecodeList(test.encoded, 0)
 		if test.expectedErr != nil {
-			require.Equal(t, test.expectedErr, err)
+			require.ErrorIs(t, err, test.expectedErr)
 		} else {
 			require.NoError(t, err)
 			require.Equal(t, len(test.encoded), bytesRead)
@@ -452,3 +477,98 @@ func TestDecodeList(t *testing.T) {
 		}
 	}
 }
+
+func TestDecoderLimits(t *testing.T) {
+	defaultLimits := rlp.DefaultLimits()
+
+	// a long string with 56 bytes of data
+	longString := append([]byte{0xb8, 0x38}, make([]byte, 56)...)
+
+	tests := []struct {
+		limits      rlp.Limits
+		encoded     []byte
+		isList      bool
+		expectedErr error
+	}{
+		// long string within the default limits
+		{defaultLimits, longString, false, nil},
+		// long string exceeding the data size limit
+		{rlp.Limits{MaxDataSize: 55, MaxListItemCount: defaultLimits.MaxListItemCount}, longString, false, rlp.ErrDataSizeTooLarge},
+		// short string within the data size limit
+		{rlp.Limits{MaxDataSize: 55, MaxListItemCount: defaultLimits.MaxListItemCount}, []byte{0x83, 0x64, 0x6f, 0x67}, false, nil},
+		// single character exceeding the data size limit
+		{rlp.Limits{MaxDataSize: 0, MaxListItemCount: defaultLimits.MaxListItemCount}, []byte{0x05}, false, rlp.ErrDataSizeTooLarge},
+		// short string exceeding the data size limit
+		{rlp.Limits{MaxDataSize: 0, MaxListItemCount: defaultLimits.MaxListItemCount}, []byte{0x81, 0x80}, false, rlp.ErrDataSizeTooLarge},
+		// list with three empty lists within the default limits
+		{defaultLimits, []byte{0xc3, 0xc0, 0xc0, 0xc0}, true, nil},
+		// list with three empty lists exceeding the list item count limit
+		{rlp.Limits{MaxDataSize: defaultLimits.MaxDataSize, MaxListItemCount: 2}, []byte{0xc3, 0xc0, 0xc0, 0xc0}, true, rlp.ErrTooManyListItems},
+		// negative data size limit is treated as zero: single character and long string are both rejected
+		{rlp.Limits{MaxDataSize: -1, MaxListItemCount: defaultLimits.MaxListItemCount}, []byte{0x05}, false, rlp.ErrDataSizeTooLarge},
+		{rlp.Limits{MaxDataSize: -1, MaxListItemCount: defaultLimits.MaxListItemCount}, longString, false, rlp.ErrDataSizeTooLarge},
+		// negative data size limit is treated as zero: empty string and empty list are accepted
+		{rlp.Limits{MaxDataSize: -1, MaxListItemCount: defaultLimits.MaxListItemCount}, []byte{0x80}, false, nil},
+		{rlp.Limits{MaxDataSize: -1, MaxListItemCount: defaultLimits.MaxListItemCount}, []byte{0xc0}, true, nil},
+		// negative list item count limit is treated as zero
+		{rlp.Limits{MaxDataSize: defaultLimits.MaxDataSize, MaxListItemCount: -1}, []byte{0xc0}, true, nil},
+		{rlp.Limits{MaxDataSize: defaultLimits.MaxDataSize, MaxListItemCount: -1}, []byte{0xc1, 0x05}, true, rlp.ErrTooManyListItems},
+	}
+
+	for _, test := range tests {
+		decoder := rlp.NewDecoder(test.limits)
+
+		var err error
+		if test.isList {
+			_, _, err = decoder.DecodeList(test.encoded, 0)
+		} else {
+			_, _, err = decoder.DecodeString(test.encoded, 0)
+		}
+
+		if test.expectedErr != nil {
+			require.ErrorIs(t, err, test.expectedErr)
+		} else {
+			require.NoError(t, err)
+		}
+	}
+}
+
+func TestDecodeErrorOffset(t *testing.T) {
+	tests := []struct {
+		encoded        []byte
+		startIndex     int
+		isList         bool
+		expectedOffset int
+		expectedErr    error
+		expectedErrMsg string
+	}{
+		// truncated long string header, starting at index 1
+		{
+			[]byte{0x00, 0xb9, 0x01}, 1, false,
+			1, rlp.ErrIncompleteInput,
+			"incomplete input! not enough bytes to read (at offset 1)",
+		},
+		// list with a single character item,
+		// followed by an item with a truncated long string header
+		{
+			[]byte{0xc3, 0x41, 0xb9, 0x01}, 0, true,
+			2, rlp.ErrIncompleteInput,
+			"incomplete input! not enough bytes to read (at offset 2)",
+		},
+	}
+
+	for _, test := range tests {
+		var err error
+		if test.isList {
+			_, _, err = rlp.DecodeList(test.encoded, test.startIndex)
+		} else {
+			_, _, err = rlp.DecodeString(test.encoded, test.startIndex)
+		}
+
+		var decodeErr rlp.DecodeError
+		require.ErrorAs(t, err, &decodeErr)
+		require.Equal(t, test.expectedOffset, decodeErr.Offset)
+		require.ErrorIs(t, err, test.expectedErr)
+		require.EqualError(t, err, test.expectedErrMsg)
+	}
+}
@@ -405,6 +405,50 @@ func TestCheckAccount_typeAt(t *testing.T) {
 	}
 }
 
+func TestCheckPublicAccount_typeAt(t *testing.T) {
+
+	t.Parallel()
+
+	test := func(domain common.PathDomain) {
+		t.Run(fmt.Sprintf("type %s", domain.Identifier()), func(t *testing.T) {
+
+			t.Parallel()
+
+			checker, err := ParseAndCheckAccount(t,
+				fmt.Sprintf(
+					`
+						let t = publicAccount.type(at: /%s/r)
+					`,
+					domain.Identifier(),
+				),
+			)
+
+			if domain == common.PathDomainPublic {
+
+				require.NoError(t, err)
+
+				typ := RequireGlobalValue(t, checker.Elaboration, "t")
+
+				require.Equal(t,
+					&sema.OptionalType{
+						Type: sema.MetaType,
+					},
+					typ,
+				)
+
+			} else {
+				errs := ExpectCheckerErrors(t, err, 1)
+
+				require.IsType(t, &sema.TypeMismatchError{}, errs[0])
+			}
+		})
+	}
+
+	for _, domain := range common.AllPathDomainsByIdentifier {
+		test(domain)
+	}
+}
+
 func TestCheckAccount_load(t *testing.T) {
 
 	t.Parallel()
@@ -1448,6 +1492,14 @@ func TestPublicAccountContracts(t *testing.T) {
 		require.NoError(t, err)
 	})
 
+	t.Run("get contract", func(t *testing.T) {
+		_, err := ParseAndCheckAccount(t, `
+            let contract: DeployedContract? = publicAccount.contracts.get(name: "foo")
+	    `)
+
+		require.NoError(t, err)
+	})
+
 	t.Run("update contracts names", func(t *testing.T) {
 		_, err := ParseAndCheckAccount(t, `
             fun test() {
@@ -1509,6 +1561,69 @@ func TestPublicAccountContracts(t *testing.T) {
 
 }
 
+func TestCheckPublicAccountKeys(t *testing.T) {
+
+	t.Parallel()
+
+	t.Run("count", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheckAccount(t, `
+            let count: UInt64 = publicAccount.keys.count
+	    `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("forEach", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheckAccount(t, `
+            fun test() {
+                publicAccount.keys.forEach(fun (key: AccountKey): Bool {
+                    return true
+                })
+            }
+	    `)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("forEach, incompatible parameter", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheckAccount(t, `
+            fun test() {
+                publicAccount.keys.forEach(fun (key: PublicKey): Bool {
+                    return true
+                })
+            }
+	    `)
+
+		require.Error(t, err)
+		errors := ExpectCheckerErrors(t, err, 1)
+		require.IsType(t, &sema.TypeMismatchError{}, errors[0])
+	})
+
+	t.Run("forEach, incompatible return", func(t *testing.T) {
+
+		t.Parallel()
+
+		_, err := ParseAndCheckAccount(t, `
+            fun test() {
+                publicAccount.keys.forEach(fun (key: AccountKey) {})
+            }
+	    `)
+
+		require.Error(t, err)
+		errors := ExpectCheckerErrors(t, err, 1)
+		require.IsType(t, &sema.TypeMismatchError{}, errors[0])
+	})
+}
+
 func TestCheckAccountPaths(t *testing.T) {
 
 	t.Parallel()
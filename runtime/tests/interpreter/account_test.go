@@ -330,6 +330,61 @@ func TestInterpretAuthAccount_type(t *testing.T) {
 	})
 }
 
+func TestInterpretPublicAccount_type(t *testing.T) {
+
+	t.Parallel()
+
+	address := interpreter.NewUnmeteredAddressValueFromBytes([]byte{42})
+
+	inter, _ := testAccount(
+		t,
+		address,
+		false,
+		`
+          resource R {}
+
+          fun saveAndLink() {
+              authAccount.save(<-create R(), to: /storage/r)
+              authAccount.link<&R>(/public/r, target: /storage/r)
+          }
+
+          fun typeAt(): Type? {
+              return account.type(at: /public/r)
+          }
+        `,
+	)
+
+	// type of empty path is nil
+
+	value, err := inter.Invoke("typeAt")
+	require.NoError(t, err)
+	require.Equal(t, interpreter.NilValue{}, value)
+
+	// type of link is the capability type
+
+	_, err = inter.Invoke("saveAndLink")
+	require.NoError(t, err)
+
+	value, err = inter.Invoke("typeAt")
+	require.NoError(t, err)
+	require.Equal(t,
+		interpreter.NewUnmeteredSomeValueNonCopying(
+			interpreter.TypeValue{
+				Type: interpreter.CapabilityStaticType{
+					BorrowType: interpreter.ReferenceStaticType{
+						BorrowedType: interpreter.CompositeStaticType{
+							Location:            utils.TestLocation,
+							QualifiedIdentifier: "R",
+							TypeID:              "S.test.R",
+						},
+					},
+				},
+			},
+		),
+		value,
+	)
+}
+
 func TestInterpretAuthAccount_load(t *testing.T) {
 
 	t.Parallel()
@@ -8895,6 +8895,10 @@ func newTestPublicAccountValue(gauge common.MemoryGauge, addressValue interprete
 				gauge,
 				addressValue,
 				panicFunction,
+				panicFunction,
+				func() interpreter.UInt64Value {
+					panic(errors.NewUnreachableError())
+				},
 			)
 		},
 		func() interpreter.Value {